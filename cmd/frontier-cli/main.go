@@ -0,0 +1,156 @@
+// Command frontier-cli runs YAML-defined portfolio strategies through the same
+// Monte Carlo / efficient-frontier pipeline as the HTTP API, persisting a dated
+// SummaryReport per run.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"frontier/internal/api"
+	"frontier/internal/config"
+	"frontier/internal/report"
+)
+
+const reportsDir = "./reports"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	switch os.Args[1] {
+	case "run":
+		runCmd(os.Args[2:])
+	case "serve":
+		serveCmd(os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: frontier-cli <run|serve> -c strategies.yaml")
+}
+
+func runCmd(args []string) {
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	configPath := fs.String("c", "", "path to strategy YAML file")
+	fs.Parse(args)
+
+	if *configPath == "" {
+		log.Fatal("run: -c strategies.yaml is required")
+	}
+
+	sf, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, s := range sf.Strategies {
+		path, err := runStrategy(s)
+		if err != nil {
+			log.Printf("strategy %q failed: %v", s.Name, err)
+			continue
+		}
+		log.Printf("strategy %q: wrote %s", s.Name, path)
+	}
+}
+
+func serveCmd(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configPath := fs.String("c", "", "path to strategy YAML file")
+	addr := fs.String("addr", ":8081", "listen address for the reports API")
+	fs.Parse(args)
+
+	if *configPath == "" {
+		log.Fatal("serve: -c strategies.yaml is required")
+	}
+
+	sf, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	c := cron.New()
+	for _, s := range sf.Strategies {
+		s := s
+		if s.Schedule == "" {
+			log.Printf("strategy %q has no schedule, skipping from serve mode", s.Name)
+			continue
+		}
+		_, err := c.AddFunc(s.Schedule, func() {
+			path, err := runStrategy(s)
+			if err != nil {
+				log.Printf("strategy %q failed: %v", s.Name, err)
+				return
+			}
+			log.Printf("strategy %q: wrote %s", s.Name, path)
+		})
+		if err != nil {
+			log.Fatalf("strategy %q: invalid schedule %q: %v", s.Name, s.Schedule, err)
+		}
+	}
+	c.Start()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/reports", api.ReportsHandler)
+	mux.HandleFunc("/api/reports/", api.ReportHandler)
+
+	log.Printf("frontier-cli serve running on http://localhost%s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+// runStrategy runs one strategy through api.Analyze and persists the result as
+// a report.SummaryReport.
+func runStrategy(s config.Strategy) (string, error) {
+	req := api.AnalyzeRequest{
+		Tickers:          s.Tickers,
+		Years:            s.Years,
+		RiskFreeRate:     s.RiskFreeRate,
+		CurrentPortfolio: s.CurrentWeights,
+		RiskMeasure:      s.RiskMeasure,
+		Alpha:            s.Alpha,
+		Source:           s.Source,
+	}
+	if s.MonteCarloSize > 0 {
+		req.NumSimulations = &s.MonteCarloSize
+	}
+
+	resp, err := api.Analyze(req)
+	if err != nil {
+		return "", fmt.Errorf("analyze: %w", err)
+	}
+
+	effectiveRange := make(map[string]report.DateRange, len(resp.EffectiveRange))
+	for ticker, dr := range resp.EffectiveRange {
+		effectiveRange[ticker] = report.DateRange{Start: dr.Start, End: dr.End}
+	}
+
+	now := time.Now().UTC()
+	r := report.SummaryReport{
+		RunID:            now.Format("20060102T150405Z"),
+		Name:             s.Name,
+		Timestamp:        now,
+		Source:           s.Source,
+		EffectiveRange:   effectiveRange,
+		Warnings:         resp.Warnings,
+		Error:            resp.Error,
+		Tickers:          resp.Tickers,
+		AssetStats:       resp.AssetStats,
+		MonteCarloPoints: resp.MonteCarloPoints,
+		FrontierPoints:   resp.FrontierPoints,
+		MaxSharpe:        resp.MaxSharpe,
+		MinVariance:      resp.MinVariance,
+	}
+
+	return report.Save(reportsDir, r)
+}
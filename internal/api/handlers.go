@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	"frontier/internal/data"
 	"frontier/internal/portfolio"
@@ -25,6 +26,32 @@ type AnalyzeRequest struct {
 	Years            int                `json:"years"`
 	RiskFreeRate     *float64           `json:"risk_free_rate"`
 	CurrentPortfolio map[string]float64 `json:"current_portfolio"`
+	// RiskMeasure selects what the efficient frontier optimizes against:
+	// "variance" (default), "semivariance" or "cvar".
+	RiskMeasure string   `json:"risk_measure"`
+	Alpha       *float64 `json:"alpha"`
+	// Source selects the price-data provider, e.g. "yahoo" (default), "stooq",
+	// "alphavantage", "csv" or "parquet". See GET /api/providers for the full list.
+	Source string `json:"source"`
+	// NumSimulations overrides the number of Monte Carlo portfolios to simulate
+	// (default 10000).
+	NumSimulations *int `json:"num_simulations"`
+
+	// MarketCaps, RiskAversion, Tau and Views are optional Black-Litterman
+	// inputs. When Views is non-empty (and MarketCaps cover every ticker), a
+	// second Monte Carlo / frontier pass is run against the Black-Litterman
+	// posterior returns and returned in AnalyzeResponse.Posterior.
+	MarketCaps   map[string]float64 `json:"market_caps"`
+	RiskAversion *float64           `json:"risk_aversion"`
+	Tau          *float64           `json:"tau"`
+	Views        []portfolio.View   `json:"views"`
+}
+
+// AnalyzeDateRange is the actual historical window covered by one ticker's
+// fetched data, which may be narrower than what was requested.
+type AnalyzeDateRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
 // AnalyzeResponse is the full JSON response
@@ -36,44 +63,45 @@ type AnalyzeResponse struct {
 	MaxSharpe             portfolio.SimulatedPortfolio   `json:"max_sharpe"`
 	MinVariance           portfolio.SimulatedPortfolio   `json:"min_variance"`
 	CurrentPortfolioStats *portfolio.SimulatedPortfolio  `json:"current_portfolio_stats,omitempty"`
+	EffectiveRange        map[string]AnalyzeDateRange    `json:"effective_range,omitempty"`
+	Posterior             *BlackLittermanResult          `json:"posterior,omitempty"`
 	Warnings              []string                       `json:"warnings,omitempty"`
 	Error                 string                         `json:"error,omitempty"`
 }
 
+// BlackLittermanResult is the frontier computed from the Black-Litterman
+// posterior returns, alongside the posterior returns themselves so the UI can
+// show how each view shifted the estimate.
+type BlackLittermanResult struct {
+	PosteriorReturns map[string]float64            `json:"posterior_returns"`
+	MonteCarloPoints []portfolio.SimulatedPortfolio `json:"monte_carlo_points"`
+	FrontierPoints   []portfolio.FrontierPoint      `json:"frontier_points"`
+	MaxSharpe        portfolio.SimulatedPortfolio   `json:"max_sharpe"`
+	MinVariance      portfolio.SimulatedPortfolio   `json:"min_variance"`
+}
+
 func writeError(w http.ResponseWriter, status int, msg string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	json.NewEncoder(w).Encode(AnalyzeResponse{Error: msg})
 }
 
-// AnalyzeHandler handles POST /api/analyze
-func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Access-Control-Allow-Origin", "*")
+// requestError is a validation failure that should be surfaced as a 400 to HTTP
+// callers; non-HTTP callers (e.g. frontier-cli) can just check the error text.
+type requestError struct{ msg string }
 
-	if r.Method == http.MethodOptions {
-		w.WriteHeader(http.StatusOK)
-		return
-	}
-	if r.Method != http.MethodPost {
-		writeError(w, http.StatusMethodNotAllowed, "only POST is supported")
-		return
-	}
-
-	var req AnalyzeRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
-		return
-	}
+func (e *requestError) Error() string { return e.msg }
 
+// Analyze runs the full frontier pipeline — fetch, prepare, Monte Carlo and
+// frontier — for a validated AnalyzeRequest. It is the shared core behind both
+// AnalyzeHandler and frontier-cli's batch runner.
+func Analyze(req AnalyzeRequest) (*AnalyzeResponse, error) {
 	// Sanitize tickers
 	if len(req.Tickers) < 2 {
-		writeError(w, http.StatusBadRequest, "please provide at least 2 tickers to compute a frontier")
-		return
+		return nil, &requestError{"please provide at least 2 tickers to compute a frontier"}
 	}
 	if len(req.Tickers) > 20 {
-		writeError(w, http.StatusBadRequest, "maximum 20 tickers allowed")
-		return
+		return nil, &requestError{"maximum 20 tickers allowed"}
 	}
 
 	// Validate years (default 2, must be integer >= 1)
@@ -82,8 +110,7 @@ func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
 		years = 2
 	}
 	if years > 100 {
-		writeError(w, http.StatusBadRequest, "maximum 100 years of historical data allowed")
-		return
+		return nil, &requestError{"maximum 100 years of historical data allowed"}
 	}
 
 	// Deduplicate and uppercase tickers
@@ -111,7 +138,7 @@ func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
 		wg.Add(1)
 		go func(i int, ticker string) {
 			defer wg.Done()
-			pd, err := data.FetchPrices(ticker, years)
+			pd, err := data.FetchPrices(req.Source, ticker, years)
 			results[i] = fetchResult{pd: pd, err: err, idx: i}
 		}(i, ticker)
 	}
@@ -144,29 +171,89 @@ func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
 		if len(errMsgs) > 0 {
 			msg += ": " + strings.Join(errMsgs, "; ")
 		}
-		writeError(w, http.StatusBadRequest, msg)
-		return
+		return nil, &requestError{msg}
 	}
 
-	// Compute portfolio math
-	_, meanReturns, covMatrix, _, assetStats := portfolio.PrepareAssets(priceData)
-
 	// Risk-free rate: default 4.5%
 	riskFreeRate := 0.045
 	if req.RiskFreeRate != nil && *req.RiskFreeRate >= 0 && *req.RiskFreeRate <= 1 {
 		riskFreeRate = *req.RiskFreeRate
 	}
 
+	// Compute portfolio math
+	preparedTickers, meanReturns, covMatrix, returnMatrix, assetStats := portfolio.PrepareAssets(priceData, riskFreeRate)
+
+	// Alpha: VaR/CVaR tail probability, default 5%
+	alpha := 0.05
+	if req.Alpha != nil && *req.Alpha > 0 && *req.Alpha < 1 {
+		alpha = *req.Alpha
+	}
+
+	riskMeasure := portfolio.Variance
+	switch strings.ToLower(req.RiskMeasure) {
+	case "semivariance":
+		riskMeasure = portfolio.Semivariance
+	case "cvar":
+		riskMeasure = portfolio.CVaR
+	}
+
+	numSims := 10000
+	if req.NumSimulations != nil && *req.NumSimulations > 0 {
+		numSims = *req.NumSimulations
+	}
+
 	// Run Monte Carlo + frontier
-	result := portfolio.RunMonteCarlo(meanReturns, covMatrix, 10000, riskFreeRate)
+	result := portfolio.RunMonteCarlo(meanReturns, covMatrix, returnMatrix, numSims, riskFreeRate, riskMeasure, alpha)
 
-	resp := AnalyzeResponse{
+	// Optional: Black-Litterman posterior pass, run against the same
+	// returnMatrix/riskFreeRate/riskMeasure/alpha/numSims as the prior.
+	var posterior *BlackLittermanResult
+	if len(req.Views) > 0 {
+		riskAversion := 2.5
+		if req.RiskAversion != nil && *req.RiskAversion > 0 {
+			riskAversion = *req.RiskAversion
+		}
+		tau := 0.05
+		if req.Tau != nil && *req.Tau > 0 {
+			tau = *req.Tau
+		}
+
+		postMean, postCov, err := portfolio.BlackLitterman(preparedTickers, covMatrix, req.MarketCaps, riskAversion, tau, req.Views)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("black-litterman: %v — skipping posterior frontier", err))
+		} else {
+			postResult := portfolio.RunMonteCarlo(postMean, postCov, returnMatrix, numSims, riskFreeRate, riskMeasure, alpha)
+			postReturns := make(map[string]float64, len(preparedTickers))
+			for i, t := range preparedTickers {
+				postReturns[t] = postMean[i]
+			}
+			posterior = &BlackLittermanResult{
+				PosteriorReturns: postReturns,
+				MonteCarloPoints: postResult.MonteCarloPoints,
+				FrontierPoints:   postResult.FrontierPoints,
+				MaxSharpe:        postResult.MaxSharpe,
+				MinVariance:      postResult.MinVariance,
+			}
+		}
+	}
+
+	effectiveRange := make(map[string]AnalyzeDateRange, len(priceData))
+	for _, pd := range priceData {
+		if len(pd.Dates) == 0 {
+			continue
+		}
+		effectiveRange[pd.Ticker] = AnalyzeDateRange{Start: pd.Dates[0], End: pd.Dates[len(pd.Dates)-1]}
+	}
+
+	resp := &AnalyzeResponse{
 		Tickers:          validTickers,
 		AssetStats:       assetStats,
 		MonteCarloPoints: result.MonteCarloPoints,
 		FrontierPoints:   result.FrontierPoints,
 		MaxSharpe:        result.MaxSharpe,
 		MinVariance:      result.MinVariance,
+		EffectiveRange:   effectiveRange,
+		Posterior:        posterior,
 		Warnings:         warnings,
 	}
 
@@ -201,5 +288,81 @@ func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	return resp, nil
+}
+
+// AnalyzeHandler handles POST /api/analyze
+func AnalyzeHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "only POST is supported")
+		return
+	}
+
+	var req AnalyzeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+		return
+	}
+
+	resp, err := Analyze(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	json.NewEncoder(w).Encode(resp)
 }
+
+// ProvidersResponse lists the available price-data sources for /api/providers.
+type ProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+// ProvidersHandler handles GET /api/providers
+func ProvidersHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "only GET is supported")
+		return
+	}
+	json.NewEncoder(w).Encode(ProvidersResponse{Providers: data.ProviderNames()})
+}
+
+// UploadResponse reports how many rows were accepted by /api/upload.
+type UploadResponse struct {
+	RowsWritten int    `json:"rows_written"`
+	Error       string `json:"error,omitempty"`
+}
+
+// UploadHandler handles POST /api/upload: a CSV body of (date,ticker,close) rows
+// that populates the local "csv" provider.
+func UploadHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		json.NewEncoder(w).Encode(UploadResponse{Error: "only POST is supported"})
+		return
+	}
+
+	rows, err := data.SaveCSVUpload(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(UploadResponse{Error: err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(UploadResponse{RowsWritten: rows})
+}
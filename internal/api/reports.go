@@ -0,0 +1,79 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"frontier/internal/report"
+)
+
+// reportsBaseDir is where frontier-cli persists SummaryReports, relative to
+// the working directory the server was started from.
+const reportsBaseDir = "./reports"
+
+// ReportsHandler handles GET /api/reports: it lists every strategy with
+// persisted reports, along with each one's manifest of past runs, so the web
+// UI can browse historical runs.
+func ReportsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	entries, err := os.ReadDir(reportsBaseDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			json.NewEncoder(w).Encode([]report.Manifest{})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	manifests := make([]report.Manifest, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		m, err := report.List(reportsBaseDir, e.Name())
+		if err != nil {
+			continue
+		}
+		manifests = append(manifests, m)
+	}
+
+	json.NewEncoder(w).Encode(manifests)
+}
+
+// ReportHandler handles GET /api/reports/{name}/{id}, returning one persisted
+// SummaryReport so the UI can diff weight allocations across runs.
+func ReportHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	rest := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/reports/"), "/")
+	parts := strings.Split(rest, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "expected /api/reports/{name}/{id}"})
+		return
+	}
+
+	rpt, err := report.Load(reportsBaseDir, parts[0], parts[1])
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+
+	json.NewEncoder(w).Encode(rpt)
+}
@@ -0,0 +1,60 @@
+// Package config parses the YAML strategy files consumed by frontier-cli.
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StrategyFile is the top-level document accepted by `frontier-cli run -c ...`
+// and `frontier-cli serve -c ...`.
+type StrategyFile struct {
+	Strategies []Strategy `yaml:"strategies"`
+}
+
+// Strategy describes one named portfolio to analyze. Tickers, Years and the
+// optional fields below map directly onto api.AnalyzeRequest.
+type Strategy struct {
+	Name           string             `yaml:"name"`
+	Tickers        []string           `yaml:"tickers"`
+	Years          int                `yaml:"years"`
+	RiskFreeRate   *float64           `yaml:"risk_free_rate"`
+	CurrentWeights map[string]float64 `yaml:"current_weights"`
+	MonteCarloSize int                `yaml:"monte_carlo_size"`
+	RiskMeasure    string             `yaml:"risk_measure"`
+	Alpha          *float64           `yaml:"alpha"`
+	Source         string             `yaml:"source"`
+
+	// Schedule is a standard 5-field cron expression. `frontier-cli run` ignores
+	// it and runs once; `frontier-cli serve` reruns the strategy on this schedule.
+	Schedule string `yaml:"schedule"`
+}
+
+// Load reads and parses a strategy YAML file, validating that every strategy
+// has a name and at least two tickers.
+func Load(path string) (*StrategyFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read strategy file %s: %w", path, err)
+	}
+
+	var sf StrategyFile
+	if err := yaml.Unmarshal(raw, &sf); err != nil {
+		return nil, fmt.Errorf("could not parse strategy file %s: %w", path, err)
+	}
+	if len(sf.Strategies) == 0 {
+		return nil, fmt.Errorf("strategy file %s defines no strategies", path)
+	}
+	for i, s := range sf.Strategies {
+		if s.Name == "" {
+			return nil, fmt.Errorf("strategy %d in %s is missing a name", i, path)
+		}
+		if len(s.Tickers) < 2 {
+			return nil, fmt.Errorf("strategy %q in %s needs at least 2 tickers", s.Name, path)
+		}
+	}
+
+	return &sf, nil
+}
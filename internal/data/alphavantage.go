@@ -0,0 +1,112 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AlphaVantageProvider fetches historical daily close prices from Alpha Vantage.
+// It requires an API key, read from the ALPHA_VANTAGE_API_KEY environment variable.
+type AlphaVantageProvider struct {
+	apiKey string
+}
+
+func NewAlphaVantageProvider() *AlphaVantageProvider {
+	return &AlphaVantageProvider{apiKey: os.Getenv("ALPHA_VANTAGE_API_KEY")}
+}
+
+func (p *AlphaVantageProvider) Name() string { return "alphavantage" }
+
+type alphaVantageResponse struct {
+	TimeSeries map[string]struct {
+		Close string `json:"4. close"`
+	} `json:"Time Series (Daily)"`
+	ErrorMessage string `json:"Error Message"`
+	Note         string `json:"Note"`
+}
+
+// FetchPrices downloads the full daily adjusted-close history available from Alpha
+// Vantage and trims it to the requested number of years.
+func (p *AlphaVantageProvider) FetchPrices(ticker string, years int) (*PriceData, error) {
+	if p.apiKey == "" {
+		return nil, fmt.Errorf("alpha vantage provider requires ALPHA_VANTAGE_API_KEY to be set")
+	}
+
+	url := fmt.Sprintf(
+		"https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&symbol=%s&outputsize=full&apikey=%s",
+		ticker, p.apiKey,
+	)
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("network error fetching %s from alpha vantage: %w", ticker, err)
+	}
+	defer resp.Body.Close()
+
+	var av alphaVantageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&av); err != nil {
+		return nil, fmt.Errorf("failed to decode alpha vantage response for %s: %w", ticker, err)
+	}
+	if av.ErrorMessage != "" {
+		return nil, fmt.Errorf("alpha vantage error for %s: %s", ticker, av.ErrorMessage)
+	}
+	if av.Note != "" {
+		return nil, fmt.Errorf("alpha vantage rate limit hit fetching %s: %s", ticker, av.Note)
+	}
+	if len(av.TimeSeries) == 0 {
+		return nil, fmt.Errorf("no data returned for ticker %s — please check if it is valid", ticker)
+	}
+
+	type point struct {
+		date  time.Time
+		close float64
+	}
+	points := make([]point, 0, len(av.TimeSeries))
+	for dateStr, v := range av.TimeSeries {
+		d, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			continue
+		}
+		c, err := strconv.ParseFloat(v.Close, 64)
+		if err != nil || c <= 0 {
+			continue
+		}
+		points = append(points, point{date: d, close: c})
+	}
+
+	// Alpha Vantage returns points keyed by date, unordered — sort ascending.
+	sort.Slice(points, func(i, j int) bool { return points[i].date.Before(points[j].date) })
+
+	prices := make([]float64, len(points))
+	dates := make([]time.Time, len(points))
+	for i, pt := range points {
+		prices[i] = pt.close
+		dates[i] = pt.date
+	}
+
+	cutoff := time.Now().AddDate(-years, 0, 0)
+	prices, dates = trimSince(prices, dates, cutoff)
+
+	if len(prices) < 30 {
+		return nil, fmt.Errorf("not enough price data for %s (got %d points, need at least 30)", ticker, len(prices))
+	}
+
+	pd := &PriceData{
+		Ticker:         strings.ToUpper(ticker),
+		Closes:         prices,
+		Dates:          dates,
+		YearsRequested: years,
+	}
+	if len(dates) > 1 {
+		pd.YearsAvail = dates[len(dates)-1].Sub(dates[0]).Hours() / 24 / 365.25
+		pd.Partial = pd.YearsAvail < float64(years)*0.95
+	}
+	return pd, nil
+}
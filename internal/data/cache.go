@@ -0,0 +1,109 @@
+package data
+
+import (
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheTTL bounds how long a cached fetch is reused before the provider is hit
+// again, so intraday reruns of the same analysis don't re-hit the network.
+const cacheTTL = 6 * time.Hour
+
+type cacheEntry struct {
+	FetchedAt time.Time
+	Data      *PriceData
+}
+
+func cacheDir() (string, error) {
+	dir, err := frontierDir()
+	if err != nil {
+		return "", err
+	}
+	cache := filepath.Join(dir, "cache")
+	if err := os.MkdirAll(cache, 0o755); err != nil {
+		return "", fmt.Errorf("could not create %s: %w", cache, err)
+	}
+	return cache, nil
+}
+
+// cacheKey derives a stable filename from (provider, ticker, interval, range).
+// The interval is currently always daily ("1d"); it's part of the key so a
+// future intraday mode doesn't collide with daily data.
+func cacheKey(provider, ticker string, years int) string {
+	raw := fmt.Sprintf("%s|%s|1d|%dy", provider, strings.ToUpper(ticker), years)
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func readCacheEntry(key string) (*PriceData, bool) {
+	dir, err := cacheDir()
+	if err != nil {
+		return nil, false
+	}
+	f, err := os.Open(filepath.Join(dir, key+".json.gz"))
+	if err != nil {
+		return nil, false
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, false
+	}
+	defer gz.Close()
+
+	var entry cacheEntry
+	if err := json.NewDecoder(gz).Decode(&entry); err != nil {
+		return nil, false
+	}
+	if time.Since(entry.FetchedAt) > cacheTTL {
+		return nil, false
+	}
+	return entry.Data, true
+}
+
+func writeCacheEntry(key string, pd *PriceData) error {
+	dir, err := cacheDir()
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(dir, key+".json.gz"))
+	if err != nil {
+		return fmt.Errorf("could not create cache file: %w", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	defer gz.Close()
+
+	return json.NewEncoder(gz).Encode(cacheEntry{FetchedAt: time.Now(), Data: pd})
+}
+
+// fetchCached wraps a Provider fetch with the on-disk TTL cache.
+func fetchCached(p Provider, ticker string, years int) (*PriceData, error) {
+	key := cacheKey(p.Name(), ticker, years)
+	if pd, ok := readCacheEntry(key); ok {
+		return pd, nil
+	}
+
+	pd, err := p.FetchPrices(ticker, years)
+	if err != nil {
+		return nil, err
+	}
+
+	// A cache write failure shouldn't fail the request — just means we hit the
+	// network again next time.
+	if err := writeCacheEntry(key, pd); err != nil {
+		log.Printf("could not write cache entry for %s: %v", ticker, err)
+	}
+
+	return pd, nil
+}
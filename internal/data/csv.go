@@ -0,0 +1,183 @@
+package data
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// frontierDir returns (and creates) the per-user directory used for the on-disk
+// cache and for uploaded CSV/Parquet data, defaulting to ~/.frontier.
+func frontierDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("could not resolve home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".frontier")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("could not create %s: %w", dir, err)
+	}
+	return dir, nil
+}
+
+func uploadsCSVPath() (string, error) {
+	dir, err := frontierDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "uploads.csv"), nil
+}
+
+// CSVProvider serves prices from a local CSV of user-uploaded closes
+// (date,ticker,close), populated via POST /api/upload.
+type CSVProvider struct{}
+
+func NewCSVProvider() *CSVProvider { return &CSVProvider{} }
+
+func (p *CSVProvider) Name() string { return "csv" }
+
+func (p *CSVProvider) FetchPrices(ticker string, years int) (*PriceData, error) {
+	path, err := uploadsCSVPath()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no uploaded CSV data found — POST closes to /api/upload first")
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	prices, dates, err := readCSVCloses(f, ticker)
+	if err != nil {
+		return nil, err
+	}
+	if len(prices) == 0 {
+		return nil, fmt.Errorf("no uploaded data found for ticker %s", ticker)
+	}
+
+	cutoff := time.Now().AddDate(-years, 0, 0)
+	prices, dates = trimSince(prices, dates, cutoff)
+
+	if len(prices) < 30 {
+		return nil, fmt.Errorf("not enough uploaded price data for %s (got %d points, need at least 30)", ticker, len(prices))
+	}
+
+	pd := &PriceData{
+		Ticker:         strings.ToUpper(ticker),
+		Closes:         prices,
+		Dates:          dates,
+		YearsRequested: years,
+	}
+	if len(dates) > 1 {
+		pd.YearsAvail = dates[len(dates)-1].Sub(dates[0]).Hours() / 24 / 365.25
+		pd.Partial = pd.YearsAvail < float64(years)*0.95
+	}
+	return pd, nil
+}
+
+// readCSVCloses reads date,ticker,close rows from r and returns the sorted,
+// ascending-by-date series for the given ticker.
+func readCSVCloses(r io.Reader, ticker string) ([]float64, []time.Time, error) {
+	type point struct {
+		date  time.Time
+		close float64
+	}
+
+	reader := csv.NewReader(r)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse uploaded CSV: %w", err)
+	}
+
+	want := strings.ToUpper(strings.TrimSpace(ticker))
+	var points []point
+	for _, row := range rows {
+		if len(row) < 3 {
+			continue
+		}
+		if strings.ToUpper(strings.TrimSpace(row[1])) != want {
+			continue
+		}
+		d, err := time.Parse("2006-01-02", strings.TrimSpace(row[0]))
+		if err != nil {
+			continue // tolerate a header row
+		}
+		c, err := strconv.ParseFloat(strings.TrimSpace(row[2]), 64)
+		if err != nil || c <= 0 {
+			continue
+		}
+		points = append(points, point{date: d, close: c})
+	}
+
+	sort.Slice(points, func(i, j int) bool { return points[i].date.Before(points[j].date) })
+
+	prices := make([]float64, len(points))
+	dates := make([]time.Time, len(points))
+	for i, pt := range points {
+		prices[i] = pt.close
+		dates[i] = pt.date
+	}
+	return prices, dates, nil
+}
+
+// SaveCSVUpload appends validated (date,ticker,close) rows from r to the local
+// uploads file backing the "csv" provider. Each row must parse as
+// date=YYYY-MM-DD, ticker=non-empty string, close=positive float; the upload is
+// rejected in full if any data row fails to parse.
+func SaveCSVUpload(r io.Reader) (rowsWritten int, err error) {
+	reader := csv.NewReader(r)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uploaded CSV: %w", err)
+	}
+
+	var valid [][]string
+	for i, row := range rows {
+		if len(row) < 3 {
+			return 0, fmt.Errorf("row %d: expected 3 columns (date,ticker,close), got %d", i+1, len(row))
+		}
+		date := strings.TrimSpace(row[0])
+		ticker := strings.ToUpper(strings.TrimSpace(row[1]))
+		closeStr := strings.TrimSpace(row[2])
+
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			if i == 0 {
+				continue // tolerate a header row
+			}
+			return 0, fmt.Errorf("row %d: invalid date %q (want YYYY-MM-DD)", i+1, date)
+		}
+		close, err := strconv.ParseFloat(closeStr, 64)
+		if err != nil || close <= 0 {
+			return 0, fmt.Errorf("row %d: invalid close price %q", i+1, closeStr)
+		}
+		valid = append(valid, []string{date, ticker, closeStr})
+	}
+
+	path, err := uploadsCSVPath()
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("could not open uploads file: %w", err)
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(valid); err != nil {
+		return 0, fmt.Errorf("could not write uploaded rows: %w", err)
+	}
+	w.Flush()
+
+	return len(valid), nil
+}
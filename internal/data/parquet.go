@@ -0,0 +1,98 @@
+package data
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// parquetRow mirrors the schema written by upstream ETL jobs: one row per
+// (date, ticker, close), mirroring the CSV upload format.
+type parquetRow struct {
+	Date   string  `parquet:"name=date, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Ticker string  `parquet:"name=ticker, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Close  float64 `parquet:"name=close, type=DOUBLE"`
+}
+
+// ParquetProvider serves prices from a user-uploaded Parquet file with
+// (date, ticker, close) columns, stored at ~/.frontier/uploads/<ticker>.parquet.
+type ParquetProvider struct{}
+
+func NewParquetProvider() *ParquetProvider { return &ParquetProvider{} }
+
+func (p *ParquetProvider) Name() string { return "parquet" }
+
+func (p *ParquetProvider) FetchPrices(ticker string, years int) (*PriceData, error) {
+	dir, err := frontierDir()
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(dir, "uploads", strings.ToUpper(ticker)+".parquet")
+
+	fr, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("no uploaded Parquet data found for %s — POST one to /api/upload first: %w", ticker, err)
+	}
+	defer fr.Close()
+
+	pr, err := reader.NewParquetReader(fr, new(parquetRow), 4)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open Parquet file for %s: %w", ticker, err)
+	}
+	defer pr.ReadStop()
+
+	numRows := int(pr.GetNumRows())
+	rows := make([]parquetRow, numRows)
+	if err := pr.Read(&rows); err != nil {
+		return nil, fmt.Errorf("failed to read Parquet rows for %s: %w", ticker, err)
+	}
+
+	want := strings.ToUpper(ticker)
+	type point struct {
+		date  time.Time
+		close float64
+	}
+	var points []point
+	for _, row := range rows {
+		if strings.ToUpper(row.Ticker) != want {
+			continue
+		}
+		d, err := time.Parse("2006-01-02", row.Date)
+		if err != nil || row.Close <= 0 {
+			continue
+		}
+		points = append(points, point{date: d, close: row.Close})
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].date.Before(points[j].date) })
+
+	prices := make([]float64, len(points))
+	dates := make([]time.Time, len(points))
+	for i, pt := range points {
+		prices[i] = pt.close
+		dates[i] = pt.date
+	}
+
+	cutoff := time.Now().AddDate(-years, 0, 0)
+	prices, dates = trimSince(prices, dates, cutoff)
+
+	if len(prices) < 30 {
+		return nil, fmt.Errorf("not enough uploaded price data for %s (got %d points, need at least 30)", ticker, len(prices))
+	}
+
+	pd := &PriceData{
+		Ticker:         want,
+		Closes:         prices,
+		Dates:          dates,
+		YearsRequested: years,
+	}
+	if len(dates) > 1 {
+		pd.YearsAvail = dates[len(dates)-1].Sub(dates[0]).Hours() / 24 / 365.25
+		pd.Partial = pd.YearsAvail < float64(years)*0.95
+	}
+	return pd, nil
+}
@@ -0,0 +1,76 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PriceData holds the close price series for a ticker
+type PriceData struct {
+	Ticker    string
+	Closes    []float64
+	Dates     []time.Time
+
+	// Partial is set when fewer years of history were available than requested.
+	Partial        bool
+	YearsAvail     float64
+	YearsRequested int
+}
+
+// Provider fetches historical daily close prices for a ticker from some backing
+// data source (a remote API, a local file, ...).
+type Provider interface {
+	// Name identifies the provider, e.g. "yahoo", used as the `source` field on
+	// AnalyzeRequest and as part of the on-disk cache key.
+	Name() string
+	FetchPrices(ticker string, years int) (*PriceData, error)
+}
+
+// providers is the registry of available price-data sources, keyed by Provider.Name().
+var providers = map[string]Provider{}
+
+func registerProvider(p Provider) {
+	providers[p.Name()] = p
+}
+
+func init() {
+	registerProvider(&YahooProvider{})
+	registerProvider(&StooqProvider{})
+	registerProvider(NewAlphaVantageProvider())
+	registerProvider(NewCSVProvider())
+	registerProvider(NewParquetProvider())
+}
+
+// ProviderNames lists the available data sources, for the /api/providers endpoint.
+func ProviderNames() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// GetProvider looks up a provider by name. An empty source defaults to "yahoo".
+func GetProvider(source string) (Provider, error) {
+	name := strings.ToLower(strings.TrimSpace(source))
+	if name == "" {
+		name = "yahoo"
+	}
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown data source %q", source)
+	}
+	return p, nil
+}
+
+// FetchPrices fetches historical daily close prices for ticker from the named source,
+// transparently caching results on disk so repeated requests for the same
+// (source, ticker, years) don't re-hit the network. An empty source defaults to "yahoo".
+func FetchPrices(source, ticker string, years int) (*PriceData, error) {
+	p, err := GetProvider(source)
+	if err != nil {
+		return nil, err
+	}
+	return fetchCached(p, ticker, years)
+}
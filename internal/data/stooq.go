@@ -0,0 +1,86 @@
+package data
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StooqProvider fetches historical daily close prices from Stooq's free CSV feed.
+// It's a useful fallback for tickers Yahoo doesn't carry or rate-limits.
+type StooqProvider struct{}
+
+func (p *StooqProvider) Name() string { return "stooq" }
+
+// FetchPrices downloads the full daily history available from Stooq and trims it
+// to the requested number of years.
+func (p *StooqProvider) FetchPrices(ticker string, years int) (*PriceData, error) {
+	url := fmt.Sprintf("https://stooq.com/q/d/l/?s=%s&i=d", strings.ToLower(ticker))
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("network error fetching %s from stooq: %w", ticker, err)
+	}
+	defer resp.Body.Close()
+
+	r := csv.NewReader(resp.Body)
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse stooq CSV for %s: %w", ticker, err)
+	}
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("no data returned for ticker %s — please check if it is valid", ticker)
+	}
+
+	// header: Date,Open,High,Low,Close,Volume
+	var prices []float64
+	var dates []time.Time
+	for _, row := range rows[1:] {
+		if len(row) < 5 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", row[0])
+		if err != nil {
+			continue
+		}
+		close, err := strconv.ParseFloat(row[4], 64)
+		if err != nil || close <= 0 {
+			continue
+		}
+		dates = append(dates, date)
+		prices = append(prices, close)
+	}
+
+	cutoff := time.Now().AddDate(-years, 0, 0)
+	prices, dates = trimSince(prices, dates, cutoff)
+
+	if len(prices) < 30 {
+		return nil, fmt.Errorf("not enough price data for %s (got %d points, need at least 30)", ticker, len(prices))
+	}
+
+	pd := &PriceData{
+		Ticker:         strings.ToUpper(ticker),
+		Closes:         prices,
+		Dates:          dates,
+		YearsRequested: years,
+	}
+	if len(dates) > 1 {
+		pd.YearsAvail = dates[len(dates)-1].Sub(dates[0]).Hours() / 24 / 365.25
+		pd.Partial = pd.YearsAvail < float64(years)*0.95
+	}
+	return pd, nil
+}
+
+// trimSince drops leading points older than cutoff, assuming prices/dates are
+// already sorted ascending by date.
+func trimSince(prices []float64, dates []time.Time, cutoff time.Time) ([]float64, []time.Time) {
+	start := 0
+	for start < len(dates) && dates[start].Before(cutoff) {
+		start++
+	}
+	return prices[start:], dates[start:]
+}
@@ -8,12 +8,10 @@ import (
 	"time"
 )
 
-// PriceData holds the close price series for a ticker
-type PriceData struct {
-	Ticker    string
-	Closes    []float64
-	Dates     []time.Time
-}
+// YahooProvider fetches historical daily close prices from Yahoo Finance.
+type YahooProvider struct{}
+
+func (p *YahooProvider) Name() string { return "yahoo" }
 
 type yahooResponse struct {
 	Chart struct {
@@ -21,7 +19,7 @@ type yahooResponse struct {
 			Meta struct {
 				Symbol string `json:"symbol"`
 			} `json:"meta"`
-			Timestamp []int64 `json:"timestamp"`
+			Timestamp  []int64 `json:"timestamp"`
 			Indicators struct {
 				Quote []struct {
 					Close []interface{} `json:"close"`
@@ -35,12 +33,12 @@ type yahooResponse struct {
 	} `json:"chart"`
 }
 
-// FetchPrices downloads historical daily close prices from Yahoo Finance
-// period: "1y", "2y", "5y"
-func FetchPrices(ticker, period string) (*PriceData, error) {
+// FetchPrices downloads historical daily close prices from Yahoo Finance for the
+// given number of years (capped to whatever history Yahoo actually has).
+func (p *YahooProvider) FetchPrices(ticker string, years int) (*PriceData, error) {
 	url := fmt.Sprintf(
-		"https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=1d&range=%s",
-		ticker, period,
+		"https://query1.finance.yahoo.com/v8/finance/chart/%s?interval=1d&range=%dy",
+		ticker, years,
 	)
 
 	client := &http.Client{Timeout: 15 * time.Second}
@@ -99,9 +97,16 @@ func FetchPrices(ticker, period string) (*PriceData, error) {
 		return nil, fmt.Errorf("not enough price data for %s (got %d points, need at least 30)", ticker, len(prices))
 	}
 
-	return &PriceData{
-		Ticker: result.Meta.Symbol,
-		Closes: prices,
-		Dates:  dates,
-	}, nil
+	pd := &PriceData{
+		Ticker:         result.Meta.Symbol,
+		Closes:         prices,
+		Dates:          dates,
+		YearsRequested: years,
+	}
+	if len(dates) > 1 {
+		pd.YearsAvail = dates[len(dates)-1].Sub(dates[0]).Hours() / 24 / 365.25
+		pd.Partial = pd.YearsAvail < float64(years)*0.95
+	}
+
+	return pd, nil
 }
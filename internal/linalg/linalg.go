@@ -0,0 +1,109 @@
+// Package linalg provides the small amount of dense linear algebra needed to
+// solve for posterior returns in Black–Litterman: Cholesky decomposition,
+// solving A x = b, and inverting symmetric positive-definite matrices.
+package linalg
+
+import (
+	"fmt"
+	"math"
+)
+
+// CholeskyDecompose computes the lower-triangular factor L such that A = L Lᵀ
+// for a symmetric positive-definite matrix A.
+func CholeskyDecompose(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := 0.0
+			for k := 0; k < j; k++ {
+				sum += l[i][k] * l[j][k]
+			}
+			if i == j {
+				v := a[i][i] - sum
+				if v <= 0 {
+					return nil, fmt.Errorf("matrix is not positive-definite at row %d", i)
+				}
+				l[i][j] = math.Sqrt(v)
+			} else {
+				l[i][j] = (a[i][j] - sum) / l[j][j]
+			}
+		}
+	}
+
+	return l, nil
+}
+
+// Solve solves A x = b for a symmetric positive-definite A via its Cholesky
+// factorization, using forward then backward substitution.
+func Solve(a [][]float64, b []float64) ([]float64, error) {
+	l, err := CholeskyDecompose(a)
+	if err != nil {
+		return nil, err
+	}
+	n := len(b)
+
+	// Forward solve L y = b
+	y := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for k := 0; k < i; k++ {
+			sum -= l[i][k] * y[k]
+		}
+		y[i] = sum / l[i][i]
+	}
+
+	// Backward solve Lᵀ x = y
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := y[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k][i] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+
+	return x, nil
+}
+
+// Inverse computes the inverse of a symmetric positive-definite matrix by
+// solving A x = e_i for each standard basis vector e_i.
+func Inverse(a [][]float64) ([][]float64, error) {
+	n := len(a)
+	inv := make([][]float64, n)
+	for i := range inv {
+		inv[i] = make([]float64, n)
+	}
+
+	for col := 0; col < n; col++ {
+		e := make([]float64, n)
+		e[col] = 1
+		x, err := Solve(a, e)
+		if err != nil {
+			return nil, err
+		}
+		for row := 0; row < n; row++ {
+			inv[row][col] = x[row]
+		}
+	}
+
+	return inv, nil
+}
+
+// MatVec computes A*v.
+func MatVec(a [][]float64, v []float64) []float64 {
+	n := len(a)
+	out := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := 0.0
+		for j, vj := range v {
+			sum += a[i][j] * vj
+		}
+		out[i] = sum
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+package linalg
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCholeskyDecompose(t *testing.T) {
+	// A = [[4, 2], [2, 3]] = L Lᵀ with L = [[2, 0], [1, sqrt(2)]]
+	a := [][]float64{
+		{4, 2},
+		{2, 3},
+	}
+
+	l, err := CholeskyDecompose(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := [][]float64{
+		{2, 0},
+		{1, math.Sqrt(2)},
+	}
+	for i := range expected {
+		for j := range expected[i] {
+			if math.Abs(l[i][j]-expected[i][j]) > 1e-9 {
+				t.Errorf("L[%d][%d]: expected %f, got %f", i, j, expected[i][j], l[i][j])
+			}
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// A x = b with A = [[4, 2], [2, 3]], b = [10, 9] => x = [1.5, 2]
+	a := [][]float64{
+		{4, 2},
+		{2, 3},
+	}
+	b := []float64{10, 9}
+
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []float64{1.5, 2}
+	for i := range expected {
+		if math.Abs(x[i]-expected[i]) > 1e-9 {
+			t.Errorf("x[%d]: expected %f, got %f", i, expected[i], x[i])
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	a := [][]float64{
+		{4, 2},
+		{2, 3},
+	}
+
+	inv, err := Inverse(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A * A^-1 should be the identity
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			sum := 0.0
+			for k := 0; k < 2; k++ {
+				sum += a[i][k] * inv[k][j]
+			}
+			want := 0.0
+			if i == j {
+				want = 1.0
+			}
+			if math.Abs(sum-want) > 1e-9 {
+				t.Errorf("(A * A^-1)[%d][%d]: expected %f, got %f", i, j, want, sum)
+			}
+		}
+	}
+}
+
+func TestCholeskyDecomposeNotPositiveDefinite(t *testing.T) {
+	a := [][]float64{
+		{1, 2},
+		{2, 1},
+	}
+	if _, err := CholeskyDecompose(a); err == nil {
+		t.Error("expected an error for a non-positive-definite matrix")
+	}
+}
@@ -0,0 +1,144 @@
+package portfolio
+
+import (
+	"fmt"
+
+	"frontier/internal/linalg"
+)
+
+// View expresses a subjective opinion about expected returns: a weighted
+// combination of assets (the portfolio the view is about) is expected to
+// return ExpectedReturn, held with the given Confidence in (0, 1].
+type View struct {
+	Assets         map[string]float64 `json:"assets"`
+	ExpectedReturn float64            `json:"expected_return"`
+	Confidence     float64            `json:"confidence"`
+}
+
+// BlackLitterman blends the market-implied equilibrium returns with subjective
+// views to produce posterior expected returns and covariance:
+//
+//	Π = λ Σ w_mkt
+//	Ω = diag( (1/conf_i - 1) * (P_i Σ P_iᵀ) * τ )
+//	μ_BL = [ (τΣ)⁻¹ + Pᵀ Ω⁻¹ P ]⁻¹ [ (τΣ)⁻¹ Π + Pᵀ Ω⁻¹ Q ]
+//	Σ_BL = Σ + [ (τΣ)⁻¹ + Pᵀ Ω⁻¹ P ]⁻¹
+//
+// With no views, it just returns the implied equilibrium returns Π and the
+// unmodified covariance.
+func BlackLitterman(tickers []string, covMatrix [][]float64, marketCaps map[string]float64, riskAversion, tau float64, views []View) (posteriorMean []float64, posteriorCov [][]float64, err error) {
+	n := len(tickers)
+
+	wMkt := make([]float64, n)
+	totalCap := 0.0
+	for _, t := range tickers {
+		totalCap += marketCaps[t]
+	}
+	if totalCap <= 0 {
+		return nil, nil, fmt.Errorf("market caps must be provided and sum to a positive value")
+	}
+	for i, t := range tickers {
+		wMkt[i] = marketCaps[t] / totalCap
+	}
+
+	// Implied equilibrium excess returns: Π = λ Σ w_mkt
+	pi := linalg.MatVec(covMatrix, wMkt)
+	for i := range pi {
+		pi[i] *= riskAversion
+	}
+
+	if len(views) == 0 {
+		return pi, covMatrix, nil
+	}
+
+	k := len(views)
+	p := make([][]float64, k)
+	q := make([]float64, k)
+	for i, v := range views {
+		p[i] = make([]float64, n)
+		for j, t := range tickers {
+			p[i][j] = v.Assets[t]
+		}
+		q[i] = v.ExpectedReturn
+	}
+
+	tauSigma := make([][]float64, n)
+	for i := range tauSigma {
+		tauSigma[i] = make([]float64, n)
+		for j := range tauSigma[i] {
+			tauSigma[i][j] = tau * covMatrix[i][j]
+		}
+	}
+	tauSigmaInv, err := linalg.Inverse(tauSigma)
+	if err != nil {
+		return nil, nil, fmt.Errorf("black-litterman: %w", err)
+	}
+
+	// Ω = diag( (1/conf_i - 1) * (P_i Σ P_iᵀ) * τ ), floored away from zero so a
+	// confidence of 1 doesn't produce a singular Ω.
+	omegaDiag := make([]float64, k)
+	for i := range views {
+		pSigma := linalg.MatVec(covMatrix, p[i])
+		pSigmaPT := 0.0
+		for j := range p[i] {
+			pSigmaPT += p[i][j] * pSigma[j]
+		}
+		conf := views[i].Confidence
+		if conf <= 0 {
+			conf = 1e-6
+		}
+		omegaDiag[i] = (1/conf - 1) * pSigmaPT * tau
+		if omegaDiag[i] <= 0 {
+			omegaDiag[i] = 1e-8
+		}
+	}
+
+	// Pᵀ Ω⁻¹ P and Pᵀ Ω⁻¹ Q, built directly since Ω is diagonal.
+	ptOmegaInvP := make([][]float64, n)
+	for i := range ptOmegaInvP {
+		ptOmegaInvP[i] = make([]float64, n)
+	}
+	ptOmegaInvQ := make([]float64, n)
+	for i := 0; i < k; i++ {
+		invOmega := 1.0 / omegaDiag[i]
+		for a := 0; a < n; a++ {
+			ptOmegaInvQ[a] += p[i][a] * invOmega * q[i]
+			for b := 0; b < n; b++ {
+				ptOmegaInvP[a][b] += p[i][a] * invOmega * p[i][b]
+			}
+		}
+	}
+
+	// A = (τΣ)⁻¹ + Pᵀ Ω⁻¹ P
+	a := make([][]float64, n)
+	for i := range a {
+		a[i] = make([]float64, n)
+		for j := range a[i] {
+			a[i][j] = tauSigmaInv[i][j] + ptOmegaInvP[i][j]
+		}
+	}
+
+	// b = (τΣ)⁻¹ Π + Pᵀ Ω⁻¹ Q
+	b := linalg.MatVec(tauSigmaInv, pi)
+	for i := range b {
+		b[i] += ptOmegaInvQ[i]
+	}
+
+	posteriorMean, err = linalg.Solve(a, b)
+	if err != nil {
+		return nil, nil, fmt.Errorf("black-litterman: %w", err)
+	}
+
+	aInv, err := linalg.Inverse(a)
+	if err != nil {
+		return nil, nil, fmt.Errorf("black-litterman: %w", err)
+	}
+	posteriorCov = make([][]float64, n)
+	for i := range posteriorCov {
+		posteriorCov[i] = make([]float64, n)
+		for j := range posteriorCov[i] {
+			posteriorCov[i][j] = covMatrix[i][j] + aInv[i][j]
+		}
+	}
+
+	return posteriorMean, posteriorCov, nil
+}
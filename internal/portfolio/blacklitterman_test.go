@@ -0,0 +1,74 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBlackLittermanNoViews(t *testing.T) {
+	tickers := []string{"A", "B"}
+	cov := [][]float64{
+		{0.04, 0.01},
+		{0.01, 0.09},
+	}
+	marketCaps := map[string]float64{"A": 60, "B": 40}
+
+	mean, posteriorCov, err := BlackLitterman(tickers, cov, marketCaps, 2.5, 0.05, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// With no views, the posterior is just the implied equilibrium returns and
+	// the original covariance.
+	wantMean := []float64{2.5 * (0.04*0.6 + 0.01*0.4), 2.5 * (0.01*0.6 + 0.09*0.4)}
+	for i := range wantMean {
+		if math.Abs(mean[i]-wantMean[i]) > 1e-9 {
+			t.Errorf("mean[%d]: expected %f, got %f", i, wantMean[i], mean[i])
+		}
+	}
+	for i := range cov {
+		for j := range cov[i] {
+			if posteriorCov[i][j] != cov[i][j] {
+				t.Errorf("posteriorCov[%d][%d]: expected %f, got %f", i, j, cov[i][j], posteriorCov[i][j])
+			}
+		}
+	}
+}
+
+func TestBlackLittermanWithView(t *testing.T) {
+	tickers := []string{"A", "B"}
+	cov := [][]float64{
+		{0.04, 0.01},
+		{0.01, 0.09},
+	}
+	marketCaps := map[string]float64{"A": 60, "B": 40}
+	views := []View{
+		{Assets: map[string]float64{"A": 1}, ExpectedReturn: 0.20, Confidence: 0.9},
+	}
+
+	mean, posteriorCov, err := BlackLitterman(tickers, cov, marketCaps, 2.5, 0.05, views)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mean) != 2 || len(posteriorCov) != 2 {
+		t.Fatalf("unexpected result shape: mean=%v cov=%v", mean, posteriorCov)
+	}
+
+	// A strong bullish view on A should pull its posterior return up relative
+	// to the unconstrained equilibrium estimate.
+	impliedA := 2.5 * (0.04*0.6 + 0.01*0.4)
+	if mean[0] <= impliedA {
+		t.Errorf("expected posterior return for A (%f) to exceed equilibrium (%f)", mean[0], impliedA)
+	}
+}
+
+func TestBlackLittermanZeroMarketCap(t *testing.T) {
+	tickers := []string{"A", "B"}
+	cov := [][]float64{
+		{0.04, 0.01},
+		{0.01, 0.09},
+	}
+	if _, _, err := BlackLitterman(tickers, cov, map[string]float64{}, 2.5, 0.05, nil); err == nil {
+		t.Error("expected an error when market caps are missing")
+	}
+}
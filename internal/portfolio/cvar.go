@@ -0,0 +1,140 @@
+package portfolio
+
+import (
+	"math"
+	"sort"
+)
+
+// RiskMeasure selects which risk metric the efficient frontier is optimized against.
+type RiskMeasure int
+
+const (
+	Variance RiskMeasure = iota
+	Semivariance
+	CVaR
+)
+
+// HistoricalVaRCVaR computes the historical (non-parametric) annualized Value-at-Risk
+// and Conditional VaR (Expected Shortfall) at the given alpha level from a weight
+// vector's empirical daily portfolio return series.
+func HistoricalVaRCVaR(weights []float64, returnMatrix [][]float64, alpha float64) (vaR, cVaR float64) {
+	const tradingDays = 252.0
+
+	rPort := portfolioReturnSeries(weights, returnMatrix)
+	if len(rPort) == 0 {
+		return 0, 0
+	}
+
+	sorted := append([]float64(nil), rPort...)
+	sort.Float64s(sorted)
+
+	tailN := int(math.Ceil(alpha * float64(len(sorted))))
+	if tailN < 1 {
+		tailN = 1
+	}
+	if tailN > len(sorted) {
+		tailN = len(sorted)
+	}
+
+	vaR = -sorted[tailN-1] * math.Sqrt(tradingDays)
+
+	sum := 0.0
+	for _, r := range sorted[:tailN] {
+		sum += r
+	}
+	cVaR = -(sum / float64(tailN)) * math.Sqrt(tradingDays)
+
+	return vaR, cVaR
+}
+
+// cvarForReturn finds the minimum-CVaR portfolio for a given target return using a
+// Rockafellar–Uryasev projected subgradient method: it jointly optimizes the weight
+// vector and the VaR threshold to minimize
+//
+//	VaR + (1/(alpha*T)) * sum_t max(0, -r_t - VaR)
+//
+// subject to sum(w) = 1, w >= 0 and w·meanReturns = targetRet, mirroring the
+// projection scheme used by minVarForReturn.
+func cvarForReturn(returnMatrix [][]float64, meanReturns []float64, targetRet, alpha float64) []float64 {
+	n := len(meanReturns)
+	tDays := len(returnMatrix[0])
+
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 1.0 / float64(n)
+	}
+	varThreshold := 0.0
+
+	lr := 0.01
+	const iters = 5000
+
+	for iter := 0; iter < iters; iter++ {
+		rPort := portfolioReturnSeries(w, returnMatrix)
+
+		// 1. Subgradient of VaR + (1/(alpha*T)) * sum_t max(0, -r_t - VaR)
+		gradW := make([]float64, n)
+		tailCount := 0
+		for t, r := range rPort {
+			if -r-varThreshold > 0 {
+				tailCount++
+				for i := range gradW {
+					gradW[i] += -(1.0 / (alpha * float64(tDays))) * returnMatrix[i][t]
+				}
+			}
+		}
+		gradVar := 1.0 - float64(tailCount)/(alpha*float64(tDays))
+
+		// 2. Gradient step
+		for i := range w {
+			w[i] -= lr * gradW[i]
+		}
+		varThreshold -= lr * gradVar
+
+		// 3. Project onto return constraint and sum(w)=1, same scheme as minVarForReturn
+		for p := 0; p < 10; p++ {
+			sumW := 0.0
+			for _, wi := range w {
+				sumW += wi
+			}
+			for i := range w {
+				w[i] += (1.0 - sumW) / float64(n)
+			}
+
+			currRet := 0.0
+			for i, wi := range w {
+				currRet += wi * meanReturns[i]
+			}
+
+			retDiff := targetRet - currRet
+			if math.Abs(retDiff) < 1e-10 {
+				break
+			}
+
+			meanMean := mean(meanReturns)
+			sqDiffSum := 0.0
+			for _, r := range meanReturns {
+				d := r - meanMean
+				sqDiffSum += d * d
+			}
+
+			if sqDiffSum > 1e-12 {
+				for i := range w {
+					w[i] += retDiff * (meanReturns[i] - meanMean) / sqDiffSum
+				}
+			}
+		}
+
+		// 4. Enforce non-negativity (w >= 0)
+		for i := range w {
+			if w[i] < 0 {
+				w[i] = 0
+			}
+		}
+
+		if iter%1000 == 999 {
+			lr *= 0.5
+		}
+	}
+
+	return w
+}
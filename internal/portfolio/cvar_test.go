@@ -0,0 +1,58 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHistoricalVaRCVaR(t *testing.T) {
+	// Single asset, 10 daily returns; worst day is -0.05, second worst -0.03.
+	returnMatrix := [][]float64{
+		{0.01, -0.05, 0.02, 0.01, -0.03, 0.015, 0.005, -0.01, 0.02, -0.02},
+	}
+	weights := []float64{1}
+	alpha := 0.2 // ceil(0.2*10) = 2 worst observations
+
+	vaR, cVaR := HistoricalVaRCVaR(weights, returnMatrix, alpha)
+
+	expectedVaR := 0.03 * math.Sqrt(252)
+	if math.Abs(vaR-expectedVaR) > 1e-9 {
+		t.Errorf("expected VaR %f, got %f", expectedVaR, vaR)
+	}
+
+	expectedCVaR := (0.05 + 0.03) / 2 * math.Sqrt(252)
+	if math.Abs(cVaR-expectedCVaR) > 1e-9 {
+		t.Errorf("expected CVaR %f, got %f", expectedCVaR, cVaR)
+	}
+
+	if cVaR < vaR {
+		t.Errorf("expected CVaR (%f) to be at least as large as VaR (%f)", cVaR, vaR)
+	}
+}
+
+func TestCVaRForReturn(t *testing.T) {
+	meanReturns := []float64{0.1, 0.2}
+	returnMatrix := [][]float64{
+		{0.001, -0.002, 0.0015, 0.0005, -0.001},
+		{0.002, 0.001, -0.003, 0.002, 0.001},
+	}
+
+	w := cvarForReturn(returnMatrix, meanReturns, 0.15, 0.05)
+	if w == nil {
+		t.Fatal("got nil weights")
+	}
+
+	sum := 0.0
+	ret := 0.0
+	for i := range w {
+		sum += w[i]
+		ret += w[i] * meanReturns[i]
+	}
+
+	if math.Abs(sum-1.0) > 1e-3 {
+		t.Errorf("weights do not sum to 1: %f", sum)
+	}
+	if math.Abs(ret-0.15) > 1e-3 {
+		t.Errorf("return does not match target: got %f, want 0.15", ret)
+	}
+}
@@ -0,0 +1,128 @@
+package portfolio
+
+import "math"
+
+// portfolioReturnSeries computes the per-day portfolio log-return series
+// r_t = sum_i w_i * r_{i,t} from an aligned returnMatrix (assets x days).
+func portfolioReturnSeries(weights []float64, returnMatrix [][]float64) []float64 {
+	if len(returnMatrix) == 0 {
+		return nil
+	}
+	days := len(returnMatrix[0])
+	rPort := make([]float64, days)
+	for i, w := range weights {
+		for t, r := range returnMatrix[i] {
+			rPort[t] += w * r
+		}
+	}
+	return rPort
+}
+
+// EquityCurve walks the per-day aligned returnMatrix and compounds the portfolio's
+// daily log-returns into a cumulative equity series starting from 1.0.
+func EquityCurve(weights []float64, returnMatrix [][]float64) []float64 {
+	rPort := portfolioReturnSeries(weights, returnMatrix)
+	equity := make([]float64, len(rPort))
+	e := 1.0
+	for t, r := range rPort {
+		e *= math.Exp(r)
+		equity[t] = e
+	}
+	return equity
+}
+
+// DrawdownSeries computes the drawdown of an equity curve relative to its running
+// peak at each point, along with the maximum (most negative) and average drawdown.
+func DrawdownSeries(equity []float64) (dd []float64, maxDD, avgDD float64) {
+	if len(equity) == 0 {
+		return nil, 0, 0
+	}
+	dd = make([]float64, len(equity))
+	peak := equity[0]
+	sum := 0.0
+	for i, e := range equity {
+		if e > peak {
+			peak = e
+		}
+		dd[i] = e/peak - 1
+		sum += dd[i]
+		if dd[i] < maxDD {
+			maxDD = dd[i]
+		}
+	}
+	avgDD = sum / float64(len(dd))
+	return
+}
+
+// Sortino computes the Sortino ratio: annualized excess return over annualized
+// downside deviation, where downside deviation only counts daily portfolio returns
+// below the daily risk-free rate.
+func Sortino(weights []float64, returnMatrix [][]float64, annualReturn, riskFreeRate float64) float64 {
+	const tradingDays = 252.0
+
+	rPort := portfolioReturnSeries(weights, returnMatrix)
+	if len(rPort) == 0 {
+		return 0
+	}
+
+	rfDaily := riskFreeRate / tradingDays
+	sumSq := 0.0
+	for _, r := range rPort {
+		d := math.Min(0, r-rfDaily)
+		sumSq += d * d
+	}
+	downsideDev := math.Sqrt(sumSq / float64(len(rPort)) * tradingDays)
+	if downsideDev == 0 {
+		return 0
+	}
+	return (annualReturn - riskFreeRate) / downsideDev
+}
+
+// extendedMetrics bundles the CAGR, Sortino, Calmar, drawdown and win-rate profile
+// for a weighted portfolio over the given aligned returnMatrix.
+type extendedMetrics struct {
+	CAGR                  float64
+	Sortino               float64
+	Calmar                float64
+	MaxDrawdown           float64
+	AvgDrawdown           float64
+	PercentProfitableDays float64
+}
+
+// computeExtendedMetrics derives the extended risk/reward profile for a weight
+// vector from its equity curve, reusing annualReturn already computed by
+// PortfolioStats so return is only ever calculated once.
+func computeExtendedMetrics(weights []float64, returnMatrix [][]float64, annualReturn, riskFreeRate float64) extendedMetrics {
+	const tradingDays = 252.0
+
+	equity := EquityCurve(weights, returnMatrix)
+	if len(equity) == 0 {
+		return extendedMetrics{}
+	}
+
+	_, maxDD, avgDD := DrawdownSeries(equity)
+
+	cagr := math.Pow(equity[len(equity)-1], tradingDays/float64(len(equity))) - 1
+
+	var calmar float64
+	if maxDD != 0 {
+		calmar = annualReturn / math.Abs(maxDD)
+	}
+
+	profitableDays := 0
+	rPort := portfolioReturnSeries(weights, returnMatrix)
+	for _, r := range rPort {
+		if r > 0 {
+			profitableDays++
+		}
+	}
+
+	return extendedMetrics{
+		CAGR:                  cagr,
+		Sortino:               Sortino(weights, returnMatrix, annualReturn, riskFreeRate),
+		Calmar:                calmar,
+		MaxDrawdown:           maxDD,
+		AvgDrawdown:           avgDD,
+		PercentProfitableDays: float64(profitableDays) / float64(len(rPort)),
+	}
+}
@@ -0,0 +1,52 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDrawdownSeries(t *testing.T) {
+	// Equity path: up to a peak of 1.2, down to 0.9 (a 25% drawdown from peak),
+	// then a partial recovery to 1.0.
+	equity := []float64{1.0, 1.2, 1.08, 0.9, 1.0}
+
+	dd, maxDD, avgDD := DrawdownSeries(equity)
+	if len(dd) != len(equity) {
+		t.Fatalf("expected %d drawdown points, got %d", len(equity), len(dd))
+	}
+
+	expectedMaxDD := 0.9/1.2 - 1 // -0.25
+	if math.Abs(maxDD-expectedMaxDD) > 1e-9 {
+		t.Errorf("expected max drawdown %f, got %f", expectedMaxDD, maxDD)
+	}
+
+	expectedDD := []float64{0, 0, 1.08/1.2 - 1, 0.9/1.2 - 1, 1.0/1.2 - 1}
+	for i := range dd {
+		if math.Abs(dd[i]-expectedDD[i]) > 1e-9 {
+			t.Errorf("at index %d: expected drawdown %f, got %f", i, expectedDD[i], dd[i])
+		}
+	}
+
+	sum := 0.0
+	for _, d := range expectedDD {
+		sum += d
+	}
+	expectedAvgDD := sum / float64(len(expectedDD))
+	if math.Abs(avgDD-expectedAvgDD) > 1e-9 {
+		t.Errorf("expected avg drawdown %f, got %f", expectedAvgDD, avgDD)
+	}
+}
+
+func TestEquityCurve(t *testing.T) {
+	returnMatrix := [][]float64{
+		{math.Log(1.1), math.Log(1.1)},
+	}
+	equity := EquityCurve([]float64{1}, returnMatrix)
+
+	if math.Abs(equity[0]-1.1) > 1e-9 {
+		t.Errorf("expected equity[0] = 1.1, got %f", equity[0])
+	}
+	if math.Abs(equity[1]-1.21) > 1e-9 {
+		t.Errorf("expected equity[1] = 1.21, got %f", equity[1])
+	}
+}
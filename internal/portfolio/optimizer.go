@@ -7,17 +7,33 @@ import (
 
 // SimulatedPortfolio represents one random portfolio
 type SimulatedPortfolio struct {
-	Weights []float64 `json:"weights"`
-	Return  float64   `json:"return"`
-	Risk    float64   `json:"risk"`
-	Sharpe  float64   `json:"sharpe"`
+	Weights               []float64 `json:"weights"`
+	Return                float64   `json:"return"`
+	Risk                  float64   `json:"risk"`
+	Sharpe                float64   `json:"sharpe"`
+	CAGR                  float64   `json:"cagr"`
+	Sortino               float64   `json:"sortino"`
+	Calmar                float64   `json:"calmar"`
+	MaxDrawdown           float64   `json:"max_drawdown"`
+	AvgDrawdown           float64   `json:"avg_drawdown"`
+	PercentProfitableDays float64   `json:"percent_profitable_days"`
+	VaR                   float64   `json:"var"`
+	CVaR                  float64   `json:"cvar"`
 }
 
 // FrontierPoint is a point on the efficient frontier line
 type FrontierPoint struct {
-	Return  float64   `json:"return"`
-	Risk    float64   `json:"risk"`
-	Weights []float64 `json:"weights"`
+	Return                float64   `json:"return"`
+	Risk                  float64   `json:"risk"`
+	Weights               []float64 `json:"weights"`
+	CAGR                  float64   `json:"cagr"`
+	Sortino               float64   `json:"sortino"`
+	Calmar                float64   `json:"calmar"`
+	MaxDrawdown           float64   `json:"max_drawdown"`
+	AvgDrawdown           float64   `json:"avg_drawdown"`
+	PercentProfitableDays float64   `json:"percent_profitable_days"`
+	VaR                   float64   `json:"var"`
+	CVaR                  float64   `json:"cvar"`
 }
 
 // OptimizationResult holds everything returned to the client
@@ -42,8 +58,12 @@ func randomWeights(n int, rng *rand.Rand) []float64 {
 	return w
 }
 
-// RunMonteCarlo simulates numSims random portfolios and finds max Sharpe and min variance
-func RunMonteCarlo(meanReturns []float64, covMatrix [][]float64, numSims int, riskFreeRate float64) OptimizationResult {
+// RunMonteCarlo simulates numSims random portfolios and finds max Sharpe and min variance.
+// returnMatrix is the per-day aligned return series from PrepareAssets, used to compute
+// each simulated portfolio's CAGR, Sortino, Calmar, drawdown, VaR and CVaR profile.
+// riskMeasure selects which risk metric the efficient frontier is optimized against;
+// alpha is the VaR/CVaR tail probability (e.g. 0.05 for a 95% confidence level).
+func RunMonteCarlo(meanReturns []float64, covMatrix [][]float64, returnMatrix [][]float64, numSims int, riskFreeRate float64, riskMeasure RiskMeasure, alpha float64) OptimizationResult {
 	rng := rand.New(rand.NewSource(42))
 	n := len(meanReturns)
 
@@ -54,12 +74,22 @@ func RunMonteCarlo(meanReturns []float64, covMatrix [][]float64, numSims int, ri
 	for s := 0; s < numSims; s++ {
 		w := randomWeights(n, rng)
 		ret, vol, sharpe := PortfolioStats(w, meanReturns, covMatrix, riskFreeRate)
+		ext := computeExtendedMetrics(w, returnMatrix, ret, riskFreeRate)
+		vaR, cVaR := HistoricalVaRCVaR(w, returnMatrix, alpha)
 
 		sp := SimulatedPortfolio{
-			Weights: w,
-			Return:  ret,
-			Risk:    vol,
-			Sharpe:  sharpe,
+			Weights:               w,
+			Return:                ret,
+			Risk:                  vol,
+			Sharpe:                sharpe,
+			CAGR:                  ext.CAGR,
+			Sortino:               ext.Sortino,
+			Calmar:                ext.Calmar,
+			MaxDrawdown:           ext.MaxDrawdown,
+			AvgDrawdown:           ext.AvgDrawdown,
+			PercentProfitableDays: ext.PercentProfitableDays,
+			VaR:                   vaR,
+			CVaR:                  cVaR,
 		}
 		sims = append(sims, sp)
 
@@ -71,7 +101,7 @@ func RunMonteCarlo(meanReturns []float64, covMatrix [][]float64, numSims int, ri
 		}
 	}
 
-	frontier := computeFrontierLine(meanReturns, covMatrix, minVar.Return, maxSharpe.Return*1.5, 60, riskFreeRate)
+	frontier := computeFrontierLine(meanReturns, covMatrix, returnMatrix, minVar.Return, maxSharpe.Return*1.5, 60, riskFreeRate, riskMeasure, alpha)
 
 	return OptimizationResult{
 		MonteCarloPoints: sims,
@@ -81,24 +111,49 @@ func RunMonteCarlo(meanReturns []float64, covMatrix [][]float64, numSims int, ri
 	}
 }
 
-// computeFrontierLine computes efficient frontier by sweeping target returns.
-// For each target return, it finds minimum-variance portfolio using gradient descent.
-func computeFrontierLine(meanReturns []float64, covMatrix [][]float64, minRet, maxRet float64, steps int, riskFreeRate float64) []FrontierPoint {
+// computeFrontierLine computes the efficient frontier by sweeping target returns.
+// For each target return, it finds the optimal portfolio for the chosen riskMeasure:
+// minimum variance, minimum semivariance, or minimum CVaR.
+func computeFrontierLine(meanReturns []float64, covMatrix [][]float64, returnMatrix [][]float64, minRet, maxRet float64, steps int, riskFreeRate float64, riskMeasure RiskMeasure, alpha float64) []FrontierPoint {
 	points := make([]FrontierPoint, 0, steps)
 
+	var semiCovMatrix [][]float64
+	if riskMeasure == Semivariance {
+		semiCovMatrix = SemiCovMatrix(returnMatrix)
+	}
+
 	for i := 0; i <= steps; i++ {
 		targetRet := minRet + (maxRet-minRet)*float64(i)/float64(steps)
-		w := minVarForReturn(meanReturns, covMatrix, targetRet)
+
+		var w []float64
+		switch riskMeasure {
+		case CVaR:
+			w = cvarForReturn(returnMatrix, meanReturns, targetRet, alpha)
+		case Semivariance:
+			w = minVarForReturn(meanReturns, semiCovMatrix, targetRet)
+		default:
+			w = minVarForReturn(meanReturns, covMatrix, targetRet)
+		}
 		if w == nil {
 			continue
 		}
 		ret, vol, _ := PortfolioStats(w, meanReturns, covMatrix, riskFreeRate)
+		ext := computeExtendedMetrics(w, returnMatrix, ret, riskFreeRate)
+		vaR, cVaR := HistoricalVaRCVaR(w, returnMatrix, alpha)
 
 		// Only include points on the upper half of the frontier (efficient part)
 		points = append(points, FrontierPoint{
-			Return:  ret,
-			Risk:    vol,
-			Weights: w,
+			Return:                ret,
+			Risk:                  vol,
+			Weights:               w,
+			CAGR:                  ext.CAGR,
+			Sortino:               ext.Sortino,
+			Calmar:                ext.Calmar,
+			MaxDrawdown:           ext.MaxDrawdown,
+			AvgDrawdown:           ext.AvgDrawdown,
+			PercentProfitableDays: ext.PercentProfitableDays,
+			VaR:                   vaR,
+			CVaR:                  cVaR,
 		})
 	}
 
@@ -74,7 +74,12 @@ func TestRunMonteCarlo(t *testing.T) {
 		{0, 0.09},
 	}
 
-	res := RunMonteCarlo(meanReturns, covMatrix, 1000, 0.05)
+	returnMatrix := [][]float64{
+		{0.01, -0.02, 0.015, 0.005, -0.01},
+		{0.02, 0.01, -0.03, 0.02, 0.01},
+	}
+
+	res := RunMonteCarlo(meanReturns, covMatrix, returnMatrix, 1000, 0.05, Variance, 0.05)
 
 	if len(res.MonteCarloPoints) != 1000 {
 		t.Errorf("expected 1000 MC points, got %d", len(res.MonteCarloPoints))
@@ -8,9 +8,15 @@ import (
 
 // AssetStats holds annualized stats for one asset
 type AssetStats struct {
-	Ticker          string  `json:"ticker"`
-	AnnualReturn    float64 `json:"annual_return"`
-	AnnualVolatility float64 `json:"annual_volatility"`
+	Ticker                string  `json:"ticker"`
+	AnnualReturn          float64 `json:"annual_return"`
+	AnnualVolatility      float64 `json:"annual_volatility"`
+	CAGR                  float64 `json:"cagr"`
+	Sortino               float64 `json:"sortino"`
+	Calmar                float64 `json:"calmar"`
+	MaxDrawdown           float64 `json:"max_drawdown"`
+	AvgDrawdown           float64 `json:"avg_drawdown"`
+	PercentProfitableDays float64 `json:"percent_profitable_days"`
 }
 
 // Returns computes log-returns from a price series
@@ -32,8 +38,9 @@ func mean(xs []float64) float64 {
 }
 
 // PrepareAssets aligns return series across all assets using common length (shortest)
-// and computes the annualized mean return vector and covariance matrix.
-func PrepareAssets(priceData []*data.PriceData) (
+// and computes the annualized mean return vector and covariance matrix. riskFreeRate
+// is used to compute each asset's Sortino and Calmar ratios.
+func PrepareAssets(priceData []*data.PriceData, riskFreeRate float64) (
 	tickers []string,
 	meanReturns []float64,
 	covMatrix [][]float64,
@@ -77,10 +84,19 @@ func PrepareAssets(priceData []*data.PriceData) (
 		}
 		variance /= float64(minLen - 1)
 
+		annualReturn := m * tradingDays
+		ext := computeExtendedMetrics([]float64{1}, [][]float64{returnMatrix[i]}, annualReturn, riskFreeRate)
+
 		stats[i] = AssetStats{
-			Ticker:           pd.Ticker,
-			AnnualReturn:     m * tradingDays,
-			AnnualVolatility: math.Sqrt(variance * tradingDays),
+			Ticker:                pd.Ticker,
+			AnnualReturn:          annualReturn,
+			AnnualVolatility:      math.Sqrt(variance * tradingDays),
+			CAGR:                  ext.CAGR,
+			Sortino:               ext.Sortino,
+			Calmar:                ext.Calmar,
+			MaxDrawdown:           ext.MaxDrawdown,
+			AvgDrawdown:           ext.AvgDrawdown,
+			PercentProfitableDays: ext.PercentProfitableDays,
 		}
 	}
 
@@ -106,6 +122,41 @@ func PrepareAssets(priceData []*data.PriceData) (
 	return
 }
 
+// SemiCovMatrix computes the downside (semi-)covariance matrix from an aligned
+// returnMatrix: like the full covariance matrix above, but only accumulating the
+// negative half of each day's deviation from the mean, annualized. This feeds the
+// Semivariance frontier, which penalizes downside deviation rather than total variance.
+func SemiCovMatrix(returnMatrix [][]float64) [][]float64 {
+	const tradingDays = 252.0
+
+	n := len(returnMatrix)
+	if n == 0 {
+		return nil
+	}
+	days := len(returnMatrix[0])
+
+	semiCov := make([][]float64, n)
+	for i := range semiCov {
+		semiCov[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		mi := mean(returnMatrix[i])
+		for j := i; j < n; j++ {
+			mj := mean(returnMatrix[j])
+			cov := 0.0
+			for k := 0; k < days; k++ {
+				di := math.Min(0, returnMatrix[i][k]-mi)
+				dj := math.Min(0, returnMatrix[j][k]-mj)
+				cov += di * dj
+			}
+			cov = cov / float64(days-1) * tradingDays
+			semiCov[i][j] = cov
+			semiCov[j][i] = cov
+		}
+	}
+	return semiCov
+}
+
 // PortfolioStats computes annual return, volatility and Sharpe ratio for given weights
 func PortfolioStats(weights, meanReturns []float64, covMatrix [][]float64, riskFreeRate float64) (ret, vol, sharpe float64) {
 	n := len(weights)
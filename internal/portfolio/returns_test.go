@@ -34,7 +34,7 @@ func TestPrepareAssets(t *testing.T) {
 		Closes: []float64{100, 110, 121}, // 10% then 10%
 	}
 
-	tickers, meanReturns, covMatrix, _, stats := PrepareAssets([]*data.PriceData{pd1, pd2})
+	tickers, meanReturns, covMatrix, _, stats := PrepareAssets([]*data.PriceData{pd1, pd2}, 0.045)
 
 	if len(tickers) != 2 {
 		t.Errorf("expected 2 tickers, got %d", len(tickers))
@@ -0,0 +1,144 @@
+// Package report persists dated SummaryReports for frontier-cli's scheduled
+// strategy runs, and lets the web UI browse and diff historical runs.
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"frontier/internal/portfolio"
+)
+
+// DateRange describes the actual historical window a ticker's data covered.
+type DateRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// SummaryReport is the full persisted result of one strategy run: the same
+// shape as api.AnalyzeResponse, plus metadata about the run itself.
+type SummaryReport struct {
+	RunID          string               `json:"run_id"`
+	Name           string               `json:"name"`
+	Timestamp      time.Time            `json:"timestamp"`
+	Source         string               `json:"source"`
+	EffectiveRange map[string]DateRange `json:"effective_range,omitempty"`
+	Warnings       []string             `json:"warnings,omitempty"`
+	Error          string               `json:"error,omitempty"`
+
+	Tickers          []string                       `json:"tickers"`
+	AssetStats       []portfolio.AssetStats         `json:"asset_stats"`
+	MonteCarloPoints []portfolio.SimulatedPortfolio `json:"monte_carlo_points"`
+	FrontierPoints   []portfolio.FrontierPoint      `json:"frontier_points"`
+	MaxSharpe        portfolio.SimulatedPortfolio   `json:"max_sharpe"`
+	MinVariance      portfolio.SimulatedPortfolio   `json:"min_variance"`
+}
+
+// ManifestEntry indexes one persisted report.
+type ManifestEntry struct {
+	RunID     string    `json:"run_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Path      string    `json:"path"`
+}
+
+// Manifest is the rolling index of every report persisted for one strategy name.
+type Manifest struct {
+	Name    string          `json:"name"`
+	Entries []ManifestEntry `json:"entries"`
+}
+
+func strategyDir(baseDir, name string) string {
+	return filepath.Join(baseDir, name)
+}
+
+func manifestPath(baseDir, name string) string {
+	return filepath.Join(strategyDir(baseDir, name), "manifest.json")
+}
+
+func reportPath(baseDir, name, runID string) string {
+	return filepath.Join(strategyDir(baseDir, name), runID+".json")
+}
+
+// Save writes r as <baseDir>/<name>/<runID>.json and appends an entry to the
+// rolling <baseDir>/<name>/manifest.json index. runID is the report's own
+// RunID, so callers control the filename (frontier-cli uses the run timestamp).
+func Save(baseDir string, r SummaryReport) (path string, err error) {
+	dir := strategyDir(baseDir, r.Name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("could not create report dir %s: %w", dir, err)
+	}
+
+	path = reportPath(baseDir, r.Name, r.RunID)
+	raw, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("could not marshal report: %w", err)
+	}
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return "", fmt.Errorf("could not write report %s: %w", path, err)
+	}
+
+	m, err := readManifest(baseDir, r.Name)
+	if err != nil {
+		return "", err
+	}
+	m.Entries = append(m.Entries, ManifestEntry{
+		RunID:     r.RunID,
+		Timestamp: r.Timestamp,
+		Path:      path,
+	})
+	if err := writeManifest(baseDir, m); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func readManifest(baseDir, name string) (Manifest, error) {
+	path := manifestPath(baseDir, name)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Manifest{Name: name}, nil
+		}
+		return Manifest{}, fmt.Errorf("could not read manifest %s: %w", path, err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return Manifest{}, fmt.Errorf("could not parse manifest %s: %w", path, err)
+	}
+	return m, nil
+}
+
+func writeManifest(baseDir string, m Manifest) error {
+	raw, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal manifest: %w", err)
+	}
+	path := manifestPath(baseDir, m.Name)
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return fmt.Errorf("could not write manifest %s: %w", path, err)
+	}
+	return nil
+}
+
+// List returns the manifest of every report persisted for the given strategy name.
+func List(baseDir, name string) (Manifest, error) {
+	return readManifest(baseDir, name)
+}
+
+// Load reads a single persisted report by strategy name and run id.
+func Load(baseDir, name, runID string) (*SummaryReport, error) {
+	path := reportPath(baseDir, name, runID)
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read report %s/%s: %w", name, runID, err)
+	}
+	var r SummaryReport
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil, fmt.Errorf("could not parse report %s/%s: %w", name, runID, err)
+	}
+	return &r, nil
+}
@@ -13,6 +13,8 @@ func main() {
 	// API routes
 	mux.HandleFunc("/api/health", api.HealthHandler)
 	mux.HandleFunc("/api/analyze", api.AnalyzeHandler)
+	mux.HandleFunc("/api/providers", api.ProvidersHandler)
+	mux.HandleFunc("/api/upload", api.UploadHandler)
 
 	// Static files
 	fs := http.FileServer(http.Dir("./static"))